@@ -48,6 +48,10 @@ const (
 	// Do not close automatically when encountering error during
 	// initialization
 	NoErrClose
+
+	// Trace lens loading, to debug a lens that is not loaded or
+	// loaded in an unexpected way
+	TraceModuleLoading
 )
 
 // Augeas encapsulates an Augeas handle.
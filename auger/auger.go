@@ -0,0 +1,116 @@
+// Package auger provides higher-level conveniences built on top of
+// honnef.co/go/augeas, for tasks that most users of the raw bindings
+// end up reimplementing themselves: translating between file system
+// paths and Augeas tree paths, listing the lenses and include globs
+// Augeas knows about, and finding or appending to specific nodes.
+package auger // import "honnef.co/go/augeas/auger"
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"honnef.co/go/augeas"
+)
+
+// FspathToAugpath converts a file system path such as "/etc/hosts"
+// into the corresponding Augeas tree path, "/files/etc/hosts",
+// quoting any segment that contains characters with special meaning
+// in path expressions.
+func FspathToAugpath(fs string) string {
+	trimmed := strings.Trim(fs, "/")
+	if trimmed == "" {
+		return "/files"
+	}
+
+	segments := strings.Split(trimmed, "/")
+	for i, segment := range segments {
+		segments[i] = quoteSegment(segment)
+	}
+
+	return "/files/" + strings.Join(segments, "/")
+}
+
+func quoteSegment(segment string) string {
+	if !strings.ContainsAny(segment, " '\"[]*") {
+		return segment
+	}
+
+	return `"` + strings.ReplaceAll(segment, `"`, `\"`) + `"`
+}
+
+// AugpathToFspath converts an Augeas tree path rooted at /files back
+// into the file system path it corresponds to, undoing any quoting
+// applied by FspathToAugpath and stripping path indices such as
+// "[2]". It returns an error if aug is not rooted at /files.
+func AugpathToFspath(aug string) (string, error) {
+	const prefix = "/files"
+
+	if aug != prefix && !strings.HasPrefix(aug, prefix+"/") {
+		return "", fmt.Errorf("auger: %q is not rooted at %s", aug, prefix)
+	}
+
+	rest := strings.TrimPrefix(aug, prefix)
+	if rest == "" {
+		return "/", nil
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = unquoteSegment(stripIndex(segment))
+	}
+
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+func stripIndex(segment string) string {
+	if i := strings.LastIndexByte(segment, '['); i != -1 && strings.HasSuffix(segment, "]") {
+		return segment[:i]
+	}
+
+	return segment
+}
+
+func unquoteSegment(segment string) string {
+	if len(segment) < 2 || segment[0] != '"' || segment[len(segment)-1] != '"' {
+		return segment
+	}
+
+	return strings.ReplaceAll(segment[1:len(segment)-1], `\"`, `"`)
+}
+
+// Lenses returns the names of the transforms currently registered
+// under /augeas/load, i.e. the module roots Augeas will consider when
+// loading files.
+func Lenses(a augeas.Augeas) ([]string, error) {
+	paths, err := a.Match("/augeas/load/*")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(paths))
+	for i, p := range paths {
+		names[i] = path.Base(p)
+	}
+
+	return names, nil
+}
+
+// Includes returns the glob patterns registered as 'incl' entries for
+// the transform named lens under /augeas/load.
+func Includes(a augeas.Augeas, lens string) ([]string, error) {
+	return a.GetAll(fmt.Sprintf("/augeas/load/%s/incl", lens))
+}
+
+// FindIncludeDirective finds every occurrence of directive (such as
+// "Include" in an Apache configuration) inside the file at fsPath,
+// returning the Augeas tree path of each match.
+func FindIncludeDirective(a augeas.Augeas, fsPath, directive string) ([]string, error) {
+	return a.Match(fmt.Sprintf("/files%s//%s", fsPath, directive))
+}
+
+// Append adds value as a new, last node labelled like the nodes
+// matching path.
+func Append(a augeas.Augeas, path, value string) error {
+	return a.Set(fmt.Sprintf("%s[last()+1]", path), value)
+}
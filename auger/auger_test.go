@@ -0,0 +1,72 @@
+package auger
+
+import "testing"
+
+func TestFspathToAugpath(t *testing.T) {
+	tests := []struct {
+		fs   string
+		want string
+	}{
+		{"/", "/files"},
+		{"", "/files"},
+		{"/etc/hosts", "/files/etc/hosts"},
+		{"/etc/httpd/conf/httpd.conf", "/files/etc/httpd/conf/httpd.conf"},
+		{"/etc/my app.conf", `/files/etc/"my app.conf"`},
+		{`/etc/say "hi".conf`, `/files/etc/"say \"hi\".conf"`},
+	}
+
+	for _, tt := range tests {
+		if got := FspathToAugpath(tt.fs); got != tt.want {
+			t.Errorf("FspathToAugpath(%q) = %q, want %q", tt.fs, got, tt.want)
+		}
+	}
+}
+
+func TestAugpathToFspath(t *testing.T) {
+	tests := []struct {
+		aug     string
+		want    string
+		wantErr bool
+	}{
+		{"/files", "/", false},
+		{"/files/etc/hosts", "/etc/hosts", false},
+		{"/files/etc/hosts[1]", "/etc/hosts", false},
+		{`/files/etc/"my app.conf"`, "/etc/my app.conf", false},
+		{`/files/etc/"say \"hi\".conf"`, `/etc/say "hi".conf`, false},
+		{"/augeas/version", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := AugpathToFspath(tt.aug)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("AugpathToFspath(%q) = %q, nil, want error", tt.aug, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("AugpathToFspath(%q) returned unexpected error: %v", tt.aug, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("AugpathToFspath(%q) = %q, want %q", tt.aug, got, tt.want)
+		}
+	}
+}
+
+func TestFspathAugpathRoundTrip(t *testing.T) {
+	paths := []string{"/", "/etc/hosts", "/etc/my app.conf", `/etc/say "hi".conf`}
+
+	for _, fs := range paths {
+		aug := FspathToAugpath(fs)
+		got, err := AugpathToFspath(aug)
+		if err != nil {
+			t.Errorf("AugpathToFspath(%q) returned unexpected error: %v", aug, err)
+			continue
+		}
+		if got != fs {
+			t.Errorf("round trip for %q via %q = %q, want %q", fs, aug, got, fs)
+		}
+	}
+}
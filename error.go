@@ -12,13 +12,18 @@ import (
 // negative values are specific to these bindings.
 type ErrorCode int
 
-// The possible error codes stored in Error.Code.
+// The binding-only error codes stored in Error.Code. These are never
+// returned by Augeas itself.
 const (
 	CouldNotInitialize ErrorCode = -2
-	NoMatch                      = -1
+	NoMatch            ErrorCode = -1
+)
 
+// The possible error codes stored in Error.Code, mirroring
+// aug_errcode_t from <augeas.h>.
+const (
 	// No error
-	NoError = 0
+	NoError ErrorCode = iota
 
 	// Out of memory
 	ENOMEM
@@ -55,6 +60,12 @@ const (
 
 	// Invalid argument in function call
 	EBADARG
+
+	// Invalid label
+	ELABEL
+
+	// Cannot copy node into its descendant
+	ECPDESC
 )
 
 // Error encapsulates errors returned by Augeas.
@@ -79,6 +90,203 @@ func (err Error) Error() string {
 		err.Message, err.MinorMessage, err.Details)
 }
 
+// Unwrap returns the typed sentinel error matching err.Code, e.g.
+// *NoMatchError for ErrorCode NoMatch or ENOMATCH, so that callers can
+// use errors.Is and errors.As to check for specific failure
+// conditions instead of comparing err.Code by hand. It returns nil
+// for codes that have no dedicated type (NoError, ENOMEM, EINTERNAL).
+func (err Error) Unwrap() error {
+	payload := baseError{err.Message, err.MinorMessage, err.Details}
+
+	switch err.Code {
+	case CouldNotInitialize:
+		return &CouldNotInitializeError{payload}
+	case NoMatch, ENOMATCH:
+		return &NoMatchError{payload}
+	case EMMATCH:
+		return &MultipleMatchesError{payload}
+	case EPATHX:
+		return &PathXError{payload}
+	case ESYNTAX:
+		return &SyntaxError{payload}
+	case ENOLENS:
+		return &NoLensError{payload}
+	case EMXFM:
+		return &MXfmError{payload}
+	case ENOSPAN:
+		return &NoSpanError{payload}
+	case EMVDESC:
+		return &MvDescError{payload}
+	case ECMDRUN:
+		return &CmdRunError{payload}
+	case EBADARG:
+		return &BadArgError{payload}
+	case ELABEL:
+		return &LabelError{payload}
+	case ECPDESC:
+		return &CpDescError{payload}
+	}
+
+	return nil
+}
+
+// baseError carries the Message/MinorMessage/Details payload shared
+// by all of the typed errors below.
+type baseError struct {
+	Message      string
+	MinorMessage string
+	Details      string
+}
+
+func (e baseError) Error() string {
+	return fmt.Sprintf("Message: %s - Minor message: %s - Details: %s",
+		e.Message, e.MinorMessage, e.Details)
+}
+
+// NoMatchError is returned when a path expression has no matching
+// node, be it because too few nodes matched (the ENOMATCH case) or
+// because the bindings themselves couldn't find the single node a
+// call such as Get or Label requires (the binding-only NoMatch case).
+type NoMatchError struct{ baseError }
+
+// Is reports whether target is also a *NoMatchError, regardless of
+// its payload, so that errors.Is(err, ErrNoMatch) works.
+func (e *NoMatchError) Is(target error) bool {
+	_, ok := target.(*NoMatchError)
+	return ok
+}
+
+// MultipleMatchesError is returned when a path expression matches
+// more nodes than the calling method can deal with.
+type MultipleMatchesError struct{ baseError }
+
+func (e *MultipleMatchesError) Is(target error) bool {
+	_, ok := target.(*MultipleMatchesError)
+	return ok
+}
+
+// PathXError is returned when a path expression is not well-formed.
+type PathXError struct{ baseError }
+
+func (e *PathXError) Is(target error) bool {
+	_, ok := target.(*PathXError)
+	return ok
+}
+
+// SyntaxError is returned when a lens fails to compile due to a
+// syntax error.
+type SyntaxError struct{ baseError }
+
+func (e *SyntaxError) Is(target error) bool {
+	_, ok := target.(*SyntaxError)
+	return ok
+}
+
+// NoLensError is returned when no lens could be found for a transform.
+type NoLensError struct{ baseError }
+
+func (e *NoLensError) Is(target error) bool {
+	_, ok := target.(*NoLensError)
+	return ok
+}
+
+// MXfmError is returned when a file is covered by more than one
+// transform.
+type MXfmError struct{ baseError }
+
+func (e *MXfmError) Is(target error) bool {
+	_, ok := target.(*MXfmError)
+	return ok
+}
+
+// NoSpanError is returned when span information was requested for a
+// node that doesn't have any, usually because EnableSpan wasn't
+// passed to New.
+type NoSpanError struct{ baseError }
+
+func (e *NoSpanError) Is(target error) bool {
+	_, ok := target.(*NoSpanError)
+	return ok
+}
+
+// MvDescError is returned by Move when asked to move a node into one
+// of its own descendants.
+type MvDescError struct{ baseError }
+
+func (e *MvDescError) Is(target error) bool {
+	_, ok := target.(*MvDescError)
+	return ok
+}
+
+// CmdRunError is returned when executing a command, such as one run
+// via Run, fails.
+type CmdRunError struct{ baseError }
+
+func (e *CmdRunError) Is(target error) bool {
+	_, ok := target.(*CmdRunError)
+	return ok
+}
+
+// BadArgError is returned when a function was called with an invalid
+// argument.
+type BadArgError struct{ baseError }
+
+func (e *BadArgError) Is(target error) bool {
+	_, ok := target.(*BadArgError)
+	return ok
+}
+
+// LabelError is returned when a label is invalid, for example because
+// it contains a '/'.
+type LabelError struct{ baseError }
+
+func (e *LabelError) Is(target error) bool {
+	_, ok := target.(*LabelError)
+	return ok
+}
+
+// CpDescError is returned by Copy when asked to copy a node into one
+// of its own descendants.
+type CpDescError struct{ baseError }
+
+func (e *CpDescError) Is(target error) bool {
+	_, ok := target.(*CpDescError)
+	return ok
+}
+
+// CouldNotInitializeError is returned by New when the Augeas handle
+// could not be created at all.
+type CouldNotInitializeError struct{ baseError }
+
+func (e *CouldNotInitializeError) Is(target error) bool {
+	_, ok := target.(*CouldNotInitializeError)
+	return ok
+}
+
+// The sentinel errors below let callers use errors.Is to check for a
+// specific failure condition, e.g.
+//
+//	if errors.Is(err, augeas.ErrNoMatch) { ... }
+//
+// Use errors.As with a pointer to the corresponding type (*NoMatchError,
+// *SyntaxError, ...) to additionally retrieve the Message,
+// MinorMessage and Details of that specific error.
+var (
+	ErrNoMatch            error = &NoMatchError{}
+	ErrMultipleMatches    error = &MultipleMatchesError{}
+	ErrPathX              error = &PathXError{}
+	ErrSyntax             error = &SyntaxError{}
+	ErrNoLens             error = &NoLensError{}
+	ErrMXfm               error = &MXfmError{}
+	ErrNoSpan             error = &NoSpanError{}
+	ErrMvDesc             error = &MvDescError{}
+	ErrCmdRun             error = &CmdRunError{}
+	ErrBadArg             error = &BadArgError{}
+	ErrLabel              error = &LabelError{}
+	ErrCpDesc             error = &CpDescError{}
+	ErrCouldNotInitialize error = &CouldNotInitializeError{}
+)
+
 func (a Augeas) error() error {
 	code := a.errorCode()
 	if code == NoError {
@@ -0,0 +1,127 @@
+package augeas
+
+// #cgo pkg-config: libxml-2.0 augeas
+// #include <augeas.h>
+// #include <stdlib.h>
+//
+// static int ns_at(const augeas *aug, const char *var, int i,
+//                   char **path, const char **label, const char **value,
+//                   char **filename) {
+//     int r = aug_ns_path(aug, var, i, path);
+//     if (r < 0) {
+//         return r;
+//     }
+//     r = aug_ns_attr(aug, var, i, value, label, filename);
+//     if (r < 0) {
+//         free(*path);
+//         *path = NULL;
+//     }
+//     return r;
+// }
+import "C"
+import (
+	"unsafe"
+)
+
+// Copy copies the node src, and all its children, to dst. dst must
+// either match exactly one node in the tree, or may not exist yet. If
+// dst exists already, it and all its descendants are deleted. If dst
+// does not exist yet, it and all its missing ancestors are created.
+//
+// Note that the node src always stays around: copying /a/b to /x
+// leaves /a/b untouched, unlike Move.
+func (a Augeas) Copy(src, dst string) error {
+	cSrc := C.CString(src)
+	defer C.free(unsafe.Pointer(cSrc))
+
+	cDst := C.CString(dst)
+	defer C.free(unsafe.Pointer(cDst))
+
+	ret := C.aug_cp(a.handle, cSrc, cDst)
+	if ret == -1 {
+		return a.error()
+	}
+
+	return nil
+}
+
+// Rename changes the label of all nodes matching path to label,
+// without moving them or their children elsewhere in the tree.
+// Returns the number of nodes renamed.
+func (a Augeas) Rename(path, label string) (int, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	cLabel := C.CString(label)
+	defer C.free(unsafe.Pointer(cLabel))
+
+	ret := C.aug_rename(a.handle, cPath, cLabel)
+	if ret == -1 {
+		return 0, a.error()
+	}
+
+	return int(ret), nil
+}
+
+// NodeSet is a handle onto the nodes matched by a path variable
+// previously defined with DefineVariable. Unlike Match, it lets
+// callers retrieve a node's path, label, value and source file in a
+// single call, instead of one round-trip per attribute.
+type NodeSet struct {
+	a       Augeas
+	varName string
+	count   int
+}
+
+// NodeSet returns the set of nodes matched by the variable varName,
+// which must already have been defined via DefineVariable.
+func (a Augeas) NodeSet(varName string) (NodeSet, error) {
+	cVarName := C.CString(varName)
+	defer C.free(unsafe.Pointer(cVarName))
+
+	ret := C.aug_ns_count(a.handle, cVarName)
+	if ret == -1 {
+		return NodeSet{}, a.error()
+	}
+
+	return NodeSet{a, varName, int(ret)}, nil
+}
+
+// Len returns the number of nodes in the set.
+func (ns NodeSet) Len() int {
+	return ns.count
+}
+
+// At returns the path, label, value and source file of the i'th node
+// in the set (0-based), along with its 1-based index among nodes
+// sharing its label, as used in path expressions such as "label[2]".
+func (ns NodeSet) At(i int) (path, label, value string, index int, filename string, err error) {
+	cVarName := C.CString(ns.varName)
+	defer C.free(unsafe.Pointer(cVarName))
+
+	var cPath, cFilename *C.char
+	var cLabel, cValue *C.char
+
+	ret := C.ns_at(ns.a.handle, cVarName, C.int(i), &cPath, &cLabel, &cValue, &cFilename)
+	if ret < 0 {
+		return "", "", "", 0, "", ns.a.error()
+	}
+
+	if cPath != nil {
+		path = C.GoString(cPath)
+		C.free(unsafe.Pointer(cPath))
+	}
+	if cLabel != nil {
+		label = C.GoString(cLabel)
+	}
+	if cValue != nil {
+		value = C.GoString(cValue)
+	}
+	if cFilename != nil {
+		filename = C.GoString(cFilename)
+		C.free(unsafe.Pointer(cFilename))
+	}
+	index = int(ret)
+
+	return path, label, value, index, filename, nil
+}
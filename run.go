@@ -0,0 +1,61 @@
+package augeas
+
+// #cgo pkg-config: libxml-2.0 augeas
+// #include <augeas.h>
+// #include <stdio.h>
+// #include <stdlib.h>
+//
+// static char *run_srun(augeas *aug, const char *text, int *nmatches) {
+//     char *buf = NULL;
+//     size_t size = 0;
+//     FILE *out = open_memstream(&buf, &size);
+//     if (out == NULL) {
+//         return NULL;
+//     }
+//     *nmatches = aug_srun(aug, out, text);
+//     fclose(out);
+//     return buf;
+// }
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+// ErrQuit is returned by Run when script contained a 'quit' command,
+// ending execution early. It does not indicate a failure.
+var ErrQuit = errors.New("augeas: script issued a quit command")
+
+// Run executes a script written in the same command language as
+// augtool, such as "set", "rm", "ins", "defnode", "store", "retrieve",
+// "print", "save", "load", "transform", "errors", "span", "context",
+// "touch", "move", "copy" and "rename", against the receiver.
+//
+// It returns the value reported by aug_srun, which is the number of
+// commands the script executed, not the number of nodes any command
+// matched, and any output the script produced, for example from
+// "print". If the script issued a "quit" command, matches is -2 and
+// err is ErrQuit.
+func (a Augeas) Run(script string) (matches int, output string, err error) {
+	cScript := C.CString(script)
+	defer C.free(unsafe.Pointer(cScript))
+
+	var cMatches C.int
+	cOutput := C.run_srun(a.handle, cScript, &cMatches)
+	if cOutput == nil {
+		return 0, "", Error{ENOMEM, "Could not allocate output stream", "", ""}
+	}
+	defer C.free(unsafe.Pointer(cOutput))
+
+	matches = int(cMatches)
+	output = C.GoString(cOutput)
+
+	if matches == -2 {
+		return matches, output, ErrQuit
+	}
+	if matches < 0 {
+		return matches, output, a.error()
+	}
+
+	return matches, output, nil
+}
@@ -0,0 +1,75 @@
+package augeas
+
+// #cgo pkg-config: libxml-2.0 augeas
+// #include <augeas.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"unsafe"
+)
+
+// TextStore parses text using the given lens and stores the
+// resulting tree under the node textPath, without reading or writing
+// any file. The path nodePath is used as the source of the text,
+// exactly as the "text" member of a transform would be, and is
+// recorded as the node's associated file so that e.g. Span and Save
+// behave consistently with tree-based loading.
+func (a Augeas) TextStore(lens, nodePath, textPath string) error {
+	cLens := C.CString(lens)
+	defer C.free(unsafe.Pointer(cLens))
+
+	cNodePath := C.CString(nodePath)
+	defer C.free(unsafe.Pointer(cNodePath))
+
+	cTextPath := C.CString(textPath)
+	defer C.free(unsafe.Pointer(cTextPath))
+
+	ret := C.aug_text_store(a.handle, cLens, cNodePath, cTextPath)
+	if ret == -1 {
+		return a.error()
+	}
+
+	return nil
+}
+
+// TextRetrieve serializes the tree at textPath back into text using
+// the given lens and stores the result in the value of nodeOutPath.
+// nodeInPath must refer to the same node that was originally passed
+// to TextStore as nodePath, so that the lens can compute a correct
+// diff against the original text.
+func (a Augeas) TextRetrieve(lens, nodeInPath, textPath, nodeOutPath string) error {
+	cLens := C.CString(lens)
+	defer C.free(unsafe.Pointer(cLens))
+
+	cNodeInPath := C.CString(nodeInPath)
+	defer C.free(unsafe.Pointer(cNodeInPath))
+
+	cTextPath := C.CString(textPath)
+	defer C.free(unsafe.Pointer(cTextPath))
+
+	cNodeOutPath := C.CString(nodeOutPath)
+	defer C.free(unsafe.Pointer(cNodeOutPath))
+
+	ret := C.aug_text_retrieve(a.handle, cLens, cNodeInPath, cTextPath, cNodeOutPath)
+	if ret == -1 {
+		return a.error()
+	}
+
+	return nil
+}
+
+// Source returns the path of the file that was used to load the node
+// at path, which must be a node underneath /files.
+func (a Augeas) Source(path string) (string, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var cFilename *C.char
+	ret := C.aug_source(a.handle, cPath, &cFilename)
+	if ret == -1 {
+		return "", a.error()
+	}
+
+	defer C.free(unsafe.Pointer(cFilename))
+	return C.GoString(cFilename), nil
+}
@@ -0,0 +1,65 @@
+package augeas
+
+// #cgo pkg-config: libxml-2.0 augeas
+// #include <augeas.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"unsafe"
+)
+
+// Transform adds a transform for file or, if file is a glob pattern,
+// for every file it matches, using the given lens. If excl is true,
+// the transform instead excludes file from whatever lens would
+// otherwise apply to it.
+//
+// This has the same effect as manually adding the equivalent
+// 'incl'/'excl' nodes under /augeas/load, but without having to know
+// the existing transforms well enough to avoid clobbering them.
+func (a Augeas) Transform(lens, file string, excl bool) error {
+	cLens := C.CString(lens)
+	defer C.free(unsafe.Pointer(cLens))
+
+	cFile := C.CString(file)
+	defer C.free(unsafe.Pointer(cFile))
+
+	var cExcl C.int
+	if excl {
+		cExcl = 1
+	}
+
+	ret := C.aug_transform(a.handle, cLens, cFile, cExcl)
+	if ret == -1 {
+		return a.error()
+	}
+
+	return nil
+}
+
+// LoadFile loads path into the tree, using the lenses and transforms
+// already defined in the tree, without re-running the whole of Load.
+// This is useful to pick up a single new or changed file without
+// paying the cost of a full autoload.
+func (a Augeas) LoadFile(path string) error {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	ret := C.aug_load_file(a.handle, cPath)
+	if ret == -1 {
+		return a.error()
+	}
+
+	return nil
+}
+
+// Context returns the current value of /augeas/context, the path
+// that relative path expressions are resolved against.
+func (a Augeas) Context() (string, error) {
+	return a.Get("/augeas/context")
+}
+
+// SetContext sets /augeas/context to path, so that subsequent path
+// expressions can be written relative to it.
+func (a Augeas) SetContext(path string) error {
+	return a.Set("/augeas/context", path)
+}
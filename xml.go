@@ -0,0 +1,41 @@
+package augeas
+
+// #cgo pkg-config: libxml-2.0 augeas
+// #include <augeas.h>
+// #include <libxml/tree.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"unsafe"
+)
+
+// ToXML serializes the subtree at path into an XML document, in the
+// format produced by aug_to_xml: each node becomes a <node> element
+// with a label attribute, and path and file attributes where Augeas
+// records them; children become nested <node> elements. A node's value
+// is the text of a <value> child element, not an attribute. The result
+// can be fed into encoding/xml, stored, or diffed.
+func (a Augeas) ToXML(path string) (string, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var root *C.xmlNode
+	ret := C.aug_to_xml(a.handle, cPath, &root, 0)
+	if ret == -1 {
+		return "", a.error()
+	}
+
+	doc := C.xmlNewDoc(nil)
+	defer C.xmlFreeDoc(doc)
+	C.xmlDocSetRootElement(doc, root)
+
+	var cBuf *C.xmlChar
+	var size C.int
+	C.xmlDocDumpMemory(doc, &cBuf, &size)
+	if cBuf == nil {
+		return "", Error{ENOMEM, "Could not serialize XML document", "", ""}
+	}
+	defer C.xmlFree(unsafe.Pointer(cBuf))
+
+	return C.GoStringN((*C.char)(unsafe.Pointer(cBuf)), size), nil
+}